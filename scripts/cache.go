@@ -0,0 +1,77 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// CachedResponse is what a ResponseCache stores for a previously fetched
+// URL, enough to make a conditional request next time and to replay the
+// body on a 304.
+type CachedResponse struct {
+	ETag         string
+	LastModified string
+	Body         string
+}
+
+// ResponseCache stores and retrieves CachedResponse values by URL, so a
+// Crawler re-run only has to refetch pages that actually changed.
+type ResponseCache interface {
+	Get(url string) (CachedResponse, bool)
+	Put(url string, resp CachedResponse)
+}
+
+// noopCache satisfies ResponseCache without storing anything, used when a
+// Crawler isn't configured with a cache.
+type noopCache struct{}
+
+func (noopCache) Get(string) (CachedResponse, bool) { return CachedResponse{}, false }
+func (noopCache) Put(string, CachedResponse)        {}
+
+// DiskCache is a ResponseCache backed by a directory of JSON files, one
+// per cached URL, named after the URL's sha256.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache rooted at dir, creating it if it
+// doesn't exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &DiskCache{dir: dir}, nil
+}
+
+func (c *DiskCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *DiskCache) Get(url string) (CachedResponse, bool) {
+	data, err := os.ReadFile(c.path(url))
+	if err != nil {
+		return CachedResponse{}, false
+	}
+
+	var resp CachedResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return CachedResponse{}, false
+	}
+
+	return resp, true
+}
+
+func (c *DiskCache) Put(url string, resp CachedResponse) {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return
+	}
+
+	// Best-effort: a failed cache write shouldn't fail the crawl.
+	_ = os.WriteFile(c.path(url), data, 0o644)
+}
@@ -0,0 +1,16 @@
+package main
+
+// classInfo holds everything extracted from a single class's Javadoc
+// page.
+type classInfo struct {
+	// Members holds every field, method, constructor, and nested type
+	// found in the class's member summary tables.
+	Members []Member
+
+	// staticIdentifiers and visibleIdentifiers are the bare names of the
+	// field and method Members, bucketed by whether they're static, for
+	// callers doing plain import completion without needing the full
+	// Member. Constructors and nested types appear in neither list.
+	staticIdentifiers  []string
+	visibleIdentifiers []string
+}
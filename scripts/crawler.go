@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/html"
+	"golang.org/x/time/rate"
+)
+
+// CrawlerConfig configures a Crawler.
+type CrawlerConfig struct {
+	// RootURL is the base URL of the Javadoc site to crawl, e.g.
+	// "https://docs.oracle.com/en/java/javase/17/docs/api/".
+	RootURL string
+
+	// Client is the HTTP client used for all requests. Defaults to
+	// http.DefaultClient.
+	Client *http.Client
+
+	// RequestsPerSecond caps the crawl rate against the target host.
+	// Defaults to 2.
+	RequestsPerSecond float64
+
+	// Concurrency is the number of worker goroutines fetching class
+	// pages in parallel. Defaults to 4.
+	Concurrency int
+
+	// Cache stores and retrieves previously fetched responses so that
+	// re-runs only refetch pages that changed. Defaults to a no-op
+	// cache.
+	Cache ResponseCache
+
+	// ExtractOptions is forwarded to populateClassInfoFromHtml for every
+	// crawled class page.
+	ExtractOptions ExtractOptions
+}
+
+// Crawler walks a Javadoc site's "all classes" index and fetches each
+// class's page to build a merged Index, honoring robots.txt and
+// rate-limiting requests against the target host.
+type Crawler struct {
+	cfg     CrawlerConfig
+	client  *http.Client
+	limiter *rate.Limiter
+	robots  *robotsPolicy
+}
+
+// NewCrawler builds a Crawler from cfg, applying defaults for zero-valued
+// fields.
+func NewCrawler(cfg CrawlerConfig) *Crawler {
+	if cfg.Client == nil {
+		cfg.Client = http.DefaultClient
+	}
+	if cfg.RequestsPerSecond <= 0 {
+		cfg.RequestsPerSecond = 2
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	if cfg.Cache == nil {
+		cfg.Cache = noopCache{}
+	}
+
+	return &Crawler{
+		cfg:     cfg,
+		client:  cfg.Client,
+		limiter: rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), 1),
+	}
+}
+
+// Index maps a crawled class's page href (relative to the Javadoc root)
+// to the classInfo extracted from it.
+type Index map[string]*classInfo
+
+// Crawl fetches the root's "all classes" page, then downloads every
+// linked class page concurrently, merging results into an Index. Pages
+// disallowed by robots.txt, non-HTML responses, and malformed members
+// are skipped rather than aborting the whole crawl.
+func (c *Crawler) Crawl(ctx context.Context) (Index, error) {
+	root, err := url.Parse(c.cfg.RootURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing root URL: %w", err)
+	}
+
+	c.robots, err = fetchRobotsPolicy(ctx, c.client, root)
+	if err != nil {
+		return nil, fmt.Errorf("fetching robots.txt: %w", err)
+	}
+
+	allClassesDoc, err := c.fetchAllClassesPage(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := findAllClassPrefixes(allClassesDoc)
+
+	type job struct{ prefix string }
+	type result struct {
+		prefix string
+		ci     *classInfo
+		warn   error // some members failed to parse; ci still holds the rest
+		err    error // fetching/parsing the page itself failed; ci is nil
+	}
+
+	jobs := make(chan job)
+	results := make(chan result)
+
+	var wg sync.WaitGroup
+	for i := 0; i < c.cfg.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				ci, warn, err := c.crawlClass(ctx, root, j.prefix)
+				results <- result{prefix: j.prefix, ci: ci, warn: warn, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for _, prefix := range prefixes {
+			select {
+			case jobs <- job{prefix: prefix}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	index := make(Index)
+	var errs MultiError
+	for r := range results {
+		switch {
+		case r.err != nil:
+			errs.Add(fmt.Errorf("%s: %w", r.prefix, r.err))
+		case r.ci != nil:
+			// Keep the class even if some of its members failed to
+			// parse: one bad row shouldn't cost us everything else we
+			// extracted from the page. The warning is still surfaced
+			// through the returned MultiError.
+			index[r.prefix] = r.ci
+			if r.warn != nil {
+				errs.Add(fmt.Errorf("%s: %w", r.prefix, r.warn))
+			}
+		}
+	}
+
+	return index, errs.ErrorOrNil()
+}
+
+// fetchAllClassesPage fetches the Javadoc "all classes" index, trying the
+// Java 9+ filename before falling back to the legacy one.
+func (c *Crawler) fetchAllClassesPage(ctx context.Context, root *url.URL) (*html.Node, error) {
+	for _, name := range []string{"allclasses-index.html", "allclasses-noframe.html", "allclasses.html"} {
+		doc, err := c.fetchHTML(ctx, root.ResolveReference(&url.URL{Path: name}))
+		if err == nil && doc != nil {
+			return doc, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no all-classes page found under %s", root)
+}
+
+// crawlClass fetches and extracts a single class page. The returned warn
+// covers members that failed to parse (ci still holds everything that
+// did); err covers failures that mean ci couldn't be produced at all.
+func (c *Crawler) crawlClass(ctx context.Context, root *url.URL, prefix string) (ci *classInfo, warn, err error) {
+	ref, err := url.Parse(prefix)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing class href %q: %w", prefix, err)
+	}
+
+	target := root.ResolveReference(ref)
+	if !c.robots.Allowed(target.Path) {
+		return nil, nil, nil
+	}
+
+	doc, err := c.fetchHTML(ctx, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if doc == nil {
+		// Non-HTML response or opted out via noindex: nothing to index,
+		// not an error.
+		return nil, nil, nil
+	}
+
+	ci = &classInfo{}
+	warn = populateClassInfoFromHtml(ci, doc, c.cfg.ExtractOptions)
+	return ci, warn, nil
+}
+
+// fetchHTML fetches u, honoring the rate limiter and on-disk cache, and
+// parses the body as HTML. It returns (nil, nil) for a non-HTML response
+// or a page opting out via <meta name="robots" content="noindex">.
+func (c *Crawler) fetchHTML(ctx context.Context, u *url.URL) (*html.Node, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", crawlerUserAgent)
+
+	cached, hasCached := c.cfg.Cache.Get(u.String())
+	if hasCached {
+		if cached.ETag != "" {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && hasCached {
+		return html.Parse(strings.NewReader(cached.Body))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s for %s", resp.Status, u)
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "text/html") {
+		return nil, nil
+	}
+
+	rawBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	body := string(rawBody)
+
+	doc, err := html.Parse(strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", u, err)
+	}
+
+	if isNoIndex(doc) {
+		return nil, nil
+	}
+
+	c.cfg.Cache.Put(u.String(), CachedResponse{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		Body:         body,
+	})
+
+	return doc, nil
+}
+
+var robotsMetaSelector = MustCompileSelector(`meta[name="robots"]`)
+
+// isNoIndex reports whether doc carries a
+// <meta name="robots" content="noindex"> (or "nofollow") opt-out.
+func isNoIndex(doc *html.Node) bool {
+	meta, found := robotsMetaSelector.MatchFirst(doc)
+	if !found {
+		return false
+	}
+
+	content, _ := getAttributeValue(meta, "content")
+	content = strings.ToLower(content)
+	return strings.Contains(content, "noindex") || strings.Contains(content, "nofollow")
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Selectors for the div-based member summary layout emitted by Javadoc
+// for Java 9 and later. There is no per-row wrapper; instead a header
+// row and each data row are siblings directly under the summary-table
+// div, and the header row's cells carry an extra "table-header" class we
+// have to filter out. Method and field tables have three columns (the
+// name lives in its own "col-second", separate from the description in
+// "col-last"); constructor and nested-class tables have two, with the
+// name and description combined in "col-second"/"col-constructor-name".
+//
+//	<div class="summary-table" id="method-summary-table">
+//	  <div class="table-header col-first">Modifier and Type</div>
+//	  <div class="table-header col-second">Method</div>
+//	  <div class="table-header col-last">Description</div>
+//	  <div class="col-first"><code><span class="modifiers">static</span> void</code></div>
+//	  <div class="col-second"><code><a href="#foo()">foo</a></code></div>
+//	  <div class="col-last"><div class="block">...</div></div>
+//	</div>
+var (
+	summaryTableDivSelector    = MustCompileSelector("div.summary-table")
+	firstColDivSelector        = MustCompileSelector("div.col-first")
+	colSecondSelector          = MustCompileSelector("div.col-second")
+	colConstructorNameSelector = MustCompileSelector("div.col-constructor-name")
+	lastColDivSelector         = MustCompileSelector("div.col-last")
+	tableHeaderSelector        = MustCompileSelector(".table-header")
+	modifiersSelector          = MustCompileSelector("span.modifiers")
+
+	// divKindSelectors maps each of Javadoc's four member summary tables
+	// to the MemberKind its rows describe, which cell carries the
+	// member's name/link, and whether the description is a separate
+	// sibling cell or combined into the name cell.
+	divKindSelectors = []struct {
+		kind                   MemberKind
+		tableSelector          Selector
+		nameSelector           Selector
+		hasSeparateDescription bool
+	}{
+		{KindNested, MustCompileSelector(`div.summary-table[id*="nested-class-summary"]`), colSecondSelector, false},
+		{KindField, MustCompileSelector(`div.summary-table[id*="field-summary"]`), colSecondSelector, true},
+		{KindConstructor, MustCompileSelector(`div.summary-table[id*="constructor-summary"]`), colConstructorNameSelector, false},
+		{KindMethod, MustCompileSelector(`div.summary-table[id*="method-summary"]`), colSecondSelector, true},
+	}
+)
+
+// divSummaryExtractor is the ClassInfoExtractor for the div-based member
+// summary layout used by Java 9+ Javadoc.
+type divSummaryExtractor struct{}
+
+func (divSummaryExtractor) Extract(ci *classInfo, doc *html.Node, opts ExtractOptions) error {
+	var errs MultiError
+	for _, ks := range divKindSelectors {
+		for _, table := range ks.tableSelector.MatchAll(doc) {
+			for _, nameCell := range ks.nameSelector.MatchAll(table) {
+				if tableHeaderSelector.Matches(nameCell) {
+					continue
+				}
+
+				descCell := nameCell
+				if ks.hasSeparateDescription {
+					descCell = nextColLast(nameCell)
+				}
+
+				m, err := buildDivMember(precedingColFirst(nameCell), nameCell, descCell, ks.kind)
+				if err != nil {
+					if opts.FailFast {
+						return err
+					}
+
+					errs.Add(err)
+					continue
+				}
+
+				if m != nil {
+					ci.addMember(*m)
+				}
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// precedingColFirst walks back over cell's preceding siblings to find the
+// data "col-first" div that starts its row, skipping the header row's
+// own col-first.
+func precedingColFirst(cell *html.Node) *html.Node {
+	for n := cell.PrevSibling; n != nil; n = n.PrevSibling {
+		if firstColDivSelector.Matches(n) && !tableHeaderSelector.Matches(n) {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// nextColLast walks forward over nameCell's following siblings to find
+// the data "col-last" div holding its description, skipping the header
+// row's own col-last.
+func nextColLast(nameCell *html.Node) *html.Node {
+	for n := nameCell.NextSibling; n != nil; n = n.NextSibling {
+		if lastColDivSelector.Matches(n) && !tableHeaderSelector.Matches(n) {
+			return n
+		}
+	}
+
+	return nil
+}
+
+func buildDivMember(first, nameCell, descCell *html.Node, kind MemberKind) (*Member, error) {
+	var (
+		modifiers  []string
+		returnType string
+	)
+
+	if first != nil {
+		modifiers, returnType = parseModifiersAndReturnTypeDiv(first)
+	}
+
+	name, err := getIdentifier(nameCell)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	anchor, _ := getAnchorHref(nameCell)
+
+	deprecated := isDeprecated(nameCell)
+	var summary string
+	if descCell != nil {
+		summary = getSummary(descCell)
+		if descCell != nameCell {
+			deprecated = deprecated || isDeprecated(descCell)
+		}
+	}
+
+	return &Member{
+		Name:       name,
+		Kind:       kind,
+		Modifiers:  modifiers,
+		ReturnType: returnType,
+		Params:     parseParams(nameCell),
+		Deprecated: deprecated,
+		Summary:    summary,
+		Anchor:     anchor,
+	}, nil
+}
+
+// parseModifiersAndReturnTypeDiv reads a div-layout col-first cell's
+// modifiers from its dedicated <span class="modifiers">, per the Java
+// 9+ markup, rather than assuming a leading text prefix the way the
+// legacy table layout does. The return type is whatever text remains in
+// the cell once the modifiers span's own text is accounted for.
+func parseModifiersAndReturnTypeDiv(first *html.Node) ([]string, string) {
+	var modifiers []string
+	if mod, ok := modifiersSelector.MatchFirst(first); ok {
+		modifiers = strings.Fields(getInnerText(mod))
+	}
+
+	full := strings.Fields(getInnerText(first))
+	if len(full) <= len(modifiers) {
+		return modifiers, ""
+	}
+
+	return modifiers, strings.Join(full[len(modifiers):], " ")
+}
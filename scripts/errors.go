@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// MultiError aggregates the errors produced while walking a page's
+// members, so a single malformed row doesn't abort indexing the rest of
+// the class. Crawl also uses it to collect per-page failures.
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return fmt.Sprintf("%d error(s):\n%s", len(m.Errors), strings.Join(msgs, "\n"))
+}
+
+// Add records err, if non-nil.
+func (m *MultiError) Add(err error) {
+	if err != nil {
+		m.Errors = append(m.Errors, err)
+	}
+}
+
+// ErrorOrNil returns m if it holds any error, nil otherwise, so it can be
+// returned directly from a func() error without an explicit length check.
+func (m *MultiError) ErrorOrNil() error {
+	if len(m.Errors) == 0 {
+		return nil
+	}
+
+	return m
+}
+
+// renderNode renders n back to its HTML source, for embedding the
+// offending node in an error message.
+func renderNode(n *html.Node) string {
+	var buf bytes.Buffer
+	if err := html.Render(&buf, n); err != nil {
+		return fmt.Sprintf("<unrenderable node: %v>", err)
+	}
+
+	return buf.String()
+}
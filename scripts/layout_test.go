@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestPopulateClassInfoFromHtml_LegacyTable(t *testing.T) {
+	doc := parseFixture(t, `
+<html><body>
+<table class="memberSummary" summary="Method Summary table, listing methods, and an explanation">
+<caption><span>Method Summary</span><span class="tabEnd">&nbsp;</span></caption>
+<tbody>
+<tr>
+<td class="colFirst"><code>static void</code></td>
+<td class="colLast"><code><a href="#foo()">foo</a></code>
+<div class="block">Does a thing.</div></td>
+</tr>
+</tbody>
+</table>
+</body></html>`)
+
+	ci := &classInfo{}
+	if err := populateClassInfoFromHtml(ci, doc, ExtractOptions{}); err != nil {
+		t.Fatalf("populateClassInfoFromHtml: %v", err)
+	}
+
+	if len(ci.Members) != 1 {
+		t.Fatalf("got %d members, want 1: %+v", len(ci.Members), ci.Members)
+	}
+
+	m := ci.Members[0]
+	if m.Name != "foo" || m.Kind != KindMethod || m.Anchor != "foo()" || m.Summary != "Does a thing." {
+		t.Errorf("unexpected member: %+v", m)
+	}
+	if !containsStatic(m.Modifiers) {
+		t.Errorf("expected a static modifier, got %v", m.Modifiers)
+	}
+}
+
+func TestPopulateClassInfoFromHtml_LegacyTable_NoSummaryAttribute(t *testing.T) {
+	doc := parseFixture(t, `
+<html><body>
+<table class="memberSummary">
+<caption><span>Field Summary</span><span class="tabEnd">&nbsp;</span></caption>
+<tbody>
+<tr>
+<td class="colFirst"><code>static final</code></td>
+<td class="colLast"><code><a href="#BAR">BAR</a></code></td>
+</tr>
+</tbody>
+</table>
+</body></html>`)
+
+	ci := &classInfo{}
+	if err := populateClassInfoFromHtml(ci, doc, ExtractOptions{}); err != nil {
+		t.Fatalf("populateClassInfoFromHtml: %v", err)
+	}
+
+	if len(ci.Members) != 1 {
+		t.Fatalf("got %d members, want 1: %+v", len(ci.Members), ci.Members)
+	}
+
+	if m := ci.Members[0]; m.Name != "BAR" || m.Kind != KindField {
+		t.Errorf("unexpected member: %+v", m)
+	}
+}
+
+func TestPopulateClassInfoFromHtml_DivSummary(t *testing.T) {
+	doc := parseFixture(t, `
+<html><body>
+<div class="summary-table three-column-summary" id="method-summary-table">
+<div class="table-header col-first">Modifier and Type</div>
+<div class="table-header col-second">Method</div>
+<div class="table-header col-last">Description</div>
+<div class="col-first"><code><span class="modifiers">static</span> void</code></div>
+<div class="col-second"><code><a href="#bar()">bar</a></code></div>
+<div class="col-last"><div class="block">Does another thing.</div></div>
+</div>
+</body></html>`)
+
+	ci := &classInfo{}
+	if err := populateClassInfoFromHtml(ci, doc, ExtractOptions{}); err != nil {
+		t.Fatalf("populateClassInfoFromHtml: %v", err)
+	}
+
+	if len(ci.Members) != 1 {
+		t.Fatalf("got %d members, want 1: %+v", len(ci.Members), ci.Members)
+	}
+
+	m := ci.Members[0]
+	if m.Name != "bar" || m.Kind != KindMethod || m.Anchor != "bar()" || m.Summary != "Does another thing." {
+		t.Errorf("unexpected member: %+v", m)
+	}
+	if !containsStatic(m.Modifiers) {
+		t.Errorf("expected a static modifier, got %v", m.Modifiers)
+	}
+}
+
+func TestPopulateClassInfoFromHtml_DivSummary_Constructor(t *testing.T) {
+	doc := parseFixture(t, `
+<html><body>
+<div class="summary-table" id="constructor-summary-table">
+<div class="table-header col-first">Modifier</div>
+<div class="table-header col-constructor-name">Constructor and Description</div>
+<div class="col-first"><code>public</code></div>
+<div class="col-constructor-name">
+<code><a href="#%3Cinit%3E()">Widget</a>()</code>
+<div class="block">Builds a Widget.</div>
+</div>
+</div>
+</body></html>`)
+
+	ci := &classInfo{}
+	if err := populateClassInfoFromHtml(ci, doc, ExtractOptions{}); err != nil {
+		t.Fatalf("populateClassInfoFromHtml: %v", err)
+	}
+
+	if len(ci.Members) != 1 {
+		t.Fatalf("got %d members, want 1: %+v", len(ci.Members), ci.Members)
+	}
+
+	m := ci.Members[0]
+	if m.Name != "Widget" || m.Kind != KindConstructor || m.Summary != "Builds a Widget." {
+		t.Errorf("unexpected member: %+v", m)
+	}
+}
+
+func parseFixture(t *testing.T, src string) *html.Node {
+	t.Helper()
+
+	doc, err := html.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("parsing fixture: %v", err)
+	}
+
+	return doc
+}
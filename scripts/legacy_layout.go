@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Selectors for the classic Javadoc member summary layout used through
+// Java 8:
+//
+//	<table class="memberSummary">
+//	  <tbody>
+//	    <tr><td class="colFirst">...</td><td class="colLast">...</td></tr>
+var (
+	firstColSelector    = MustCompileSelector("td.colFirst")
+	lastColSelector     = MustCompileSelector("td.colLast")
+	memberTableSelector = MustCompileSelector("table.memberSummary")
+	memberRowSelector   = MustCompileSelector("tbody tr")
+	captionSelector     = MustCompileSelector("caption")
+)
+
+// tableSummaryExtractor is the ClassInfoExtractor for the legacy
+// <table class="memberSummary"> layout.
+type tableSummaryExtractor struct{}
+
+func (tableSummaryExtractor) Extract(ci *classInfo, doc *html.Node, opts ExtractOptions) error {
+	var errs MultiError
+	for _, table := range memberTableSelector.MatchAll(doc) {
+		kind := tableKind(table)
+		for _, row := range memberRowSelector.MatchAll(table) {
+			m, err := buildMember(row, kind)
+			if err != nil {
+				if opts.FailFast {
+					return err
+				}
+
+				errs.Add(err)
+				continue
+			}
+
+			if m != nil {
+				ci.addMember(*m)
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// tableKind classifies a memberSummary table by its accessibility
+// "summary" attribute when present. Some Java 8 revisions dropped that
+// attribute in favor of a <caption>, so we fall back to the caption's
+// text; a table with neither defaults to KindMethod, the most common of
+// the four, so it's still indexed instead of silently dropped.
+func tableKind(table *html.Node) MemberKind {
+	text, _ := getAttributeValue(table, "summary")
+	if text == "" {
+		if caption, ok := captionSelector.MatchFirst(table); ok {
+			text = getInnerText(caption)
+		}
+	}
+
+	switch {
+	case strings.Contains(text, "Nested Class"):
+		return KindNested
+	case strings.Contains(text, "Field"):
+		return KindField
+	case strings.Contains(text, "Constructor"):
+		return KindConstructor
+	default:
+		return KindMethod
+	}
+}
+
+func buildMember(row *html.Node, kind MemberKind) (*Member, error) {
+	var (
+		modifiers  []string
+		returnType string
+	)
+
+	if first, ok := firstColSelector.MatchFirst(row); ok {
+		modifiers, returnType = parseModifiersAndReturnType(first)
+	}
+
+	last, ok := lastColSelector.MatchFirst(row)
+	if !ok {
+		return nil, nil
+	}
+
+	name, err := getIdentifier(last)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	anchor, _ := getAnchorHref(last)
+
+	return &Member{
+		Name:       name,
+		Kind:       kind,
+		Modifiers:  modifiers,
+		ReturnType: returnType,
+		Params:     parseParams(last),
+		Deprecated: isDeprecated(last),
+		Summary:    getSummary(last),
+		Anchor:     anchor,
+	}, nil
+}
+
+// parseModifiersAndReturnType splits a legacy colFirst cell's text (e.g.
+// "static void", or just "public" for a constructor) into its modifier
+// keywords and trailing return type.
+func parseModifiersAndReturnType(first *html.Node) ([]string, string) {
+	fields := strings.Fields(getInnerText(first))
+	if len(fields) == 0 {
+		return nil, ""
+	}
+
+	return fields[:len(fields)-1], fields[len(fields)-1]
+}
+
+// getIdentifier extracts the identifier from a "class=colLast" td.
+//
+// The identifier is the text of the member's link, but real Javadoc pages
+// nest <code>, <wbr>, deprecation <span>s and generic type parameters
+// inside that link rather than a single text child, so we flatten every
+// descendant text node instead of assuming one. A cell with no link at
+// all isn't decoration we can see past, so that's reported as an error
+// with the offending cell's rendered HTML for debugging.
+func getIdentifier(td *html.Node) (string, error) {
+	a, ok := anchorSelector.MatchFirst(td)
+	if !ok {
+		return "", fmt.Errorf("no link found in cell: %s", renderNode(td))
+	}
+
+	return getInnerText(a), nil
+}
+
+func getInnerText(parent *html.Node) string {
+	var explore func(*html.Node) string
+	explore = func(n *html.Node) string {
+		if n.Type == html.TextNode {
+			return n.Data
+		}
+
+		var combined string
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			combined += explore(c)
+		}
+
+		return combined
+	}
+
+	return explore(parent)
+}
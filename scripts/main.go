@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("javadoc-indexer", flag.ExitOnError)
+	root := fs.String("root", "", "root URL of the Javadoc site to crawl")
+	format := fs.String("format", string(FormatJSON), "output format: json, sqlite, or lsp")
+	out := fs.String("out", "-", `output path, or "-" for stdout (sqlite needs a file path)`)
+	cacheDir := fs.String("cache-dir", "", "directory to cache fetched pages in, revalidated by ETag/Last-Modified")
+	rps := fs.Float64("rps", 2, "maximum requests per second against the target host")
+	concurrency := fs.Int("concurrency", 4, "number of class pages to fetch concurrently")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *root == "" {
+		return fmt.Errorf("-root is required")
+	}
+
+	cfg := CrawlerConfig{
+		RootURL:           *root,
+		RequestsPerSecond: *rps,
+		Concurrency:       *concurrency,
+	}
+
+	if *cacheDir != "" {
+		cache, err := NewDiskCache(*cacheDir)
+		if err != nil {
+			return fmt.Errorf("setting up cache: %w", err)
+		}
+
+		cfg.Cache = cache
+	}
+
+	idx, err := NewCrawler(cfg).Crawl(context.Background())
+	if err != nil {
+		if idx == nil {
+			return fmt.Errorf("crawling %s: %w", *root, err)
+		}
+
+		// Per-page and per-member failures still leave a usable index.
+		fmt.Fprintf(os.Stderr, "warning: crawling %s: %v\n", *root, err)
+	}
+
+	return writeIndex(OutputFormat(*format), *out, *root, idx)
+}
+
+func writeIndex(format OutputFormat, out, rootURL string, idx Index) error {
+	switch format {
+	case FormatSQLite, FormatLSP, FormatJSON:
+	default:
+		return fmt.Errorf("unknown -format %q: want json, sqlite, or lsp", format)
+	}
+
+	if format == FormatSQLite {
+		if out == "" || out == "-" {
+			return fmt.Errorf("-out must name a file path for -format=sqlite")
+		}
+
+		return WriteSQLite(out, idx)
+	}
+
+	// Only reached once format is known good, so an unknown -format never
+	// truncates -out before we report the error.
+	w, closeW, err := openOutput(out)
+	if err != nil {
+		return err
+	}
+	defer closeW()
+
+	if format == FormatLSP {
+		return WriteLSP(w, rootURL, idx)
+	}
+
+	return WriteJSON(w, idx)
+}
+
+func openOutput(path string) (io.Writer, func(), error) {
+	if path == "" || path == "-" {
+		return os.Stdout, func() {}, nil
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating %s: %w", path, err)
+	}
+
+	return f, func() { f.Close() }, nil
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// MemberKind classifies a Member's role within a class's member summary.
+type MemberKind int
+
+const (
+	KindField MemberKind = iota
+	KindMethod
+	KindConstructor
+	KindNested
+)
+
+// String returns the label used for a MemberKind in the JSON and SQLite
+// output schemas. The LSP output maps kinds through lspSymbolKind instead.
+func (k MemberKind) String() string {
+	switch k {
+	case KindMethod:
+		return "method"
+	case KindConstructor:
+		return "ctor"
+	case KindNested:
+		return "nested"
+	default:
+		return "field"
+	}
+}
+
+// Param is a single method or constructor parameter.
+type Param struct {
+	Type string
+	Name string
+}
+
+// Member is one row of a class's member summary: a field, method,
+// constructor, or nested type, together with enough of its signature and
+// Javadoc summary to disambiguate overloads and link back to the page it
+// came from.
+type Member struct {
+	Name       string
+	Kind       MemberKind
+	Modifiers  []string
+	ReturnType string
+	Params     []Param
+	Deprecated bool
+	Summary    string
+	Anchor     string
+}
+
+// addMember records m on ci. For a field or method it additionally buckets
+// the bare name into the static/visible identifier lists that callers
+// already rely on for plain import completion; constructors and nested
+// types are recorded in Members only.
+func (ci *classInfo) addMember(m Member) {
+	ci.Members = append(ci.Members, m)
+
+	switch m.Kind {
+	case KindField, KindMethod:
+		if containsStatic(m.Modifiers) {
+			ci.staticIdentifiers = append(ci.staticIdentifiers, m.Name)
+		} else {
+			ci.visibleIdentifiers = append(ci.visibleIdentifiers, m.Name)
+		}
+	}
+}
+
+func containsStatic(modifiers []string) bool {
+	for _, m := range modifiers {
+		if m == "static" {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	codeSelector         = MustCompileSelector("code")
+	anchorSelector       = MustCompileSelector("a")
+	summaryBlockSelector = MustCompileSelector("div.block")
+
+	// deprecatedLabelSelector covers both the legacy ("deprecatedLabel")
+	// and Java 9+ ("deprecated-label") class names Javadoc has used for
+	// this marker.
+	deprecatedLabelSelector = MustCompileSelector("span.deprecatedLabel, span.deprecated-label")
+)
+
+// getAnchorHref returns the URL fragment (without the leading "#") of the
+// first link found under col, so a Member can be linked back to its
+// section of the Javadoc page.
+func getAnchorHref(col *html.Node) (string, bool) {
+	a, ok := anchorSelector.MatchFirst(col)
+	if !ok {
+		return "", false
+	}
+
+	href, found := getAttributeValue(a, "href")
+	if !found {
+		return "", false
+	}
+
+	_, frag, found := strings.Cut(href, "#")
+	return frag, found
+}
+
+// parseParams extracts the parameter list from the <code>name(...)</code>
+// block in a member's colLast/col-last cell.
+func parseParams(col *html.Node) []Param {
+	code, ok := codeSelector.MatchFirst(col)
+	if !ok {
+		return nil
+	}
+
+	text := getInnerText(code)
+	open := strings.Index(text, "(")
+	closeParen := strings.LastIndex(text, ")")
+	if open < 0 || closeParen < open {
+		return nil
+	}
+
+	inner := strings.TrimSpace(text[open+1 : closeParen])
+	if inner == "" {
+		return nil
+	}
+
+	var params []Param
+	for _, part := range strings.Split(inner, ",") {
+		fields := strings.Fields(part)
+		switch len(fields) {
+		case 0:
+			continue
+		case 1:
+			params = append(params, Param{Type: fields[0]})
+		default:
+			params = append(params, Param{
+				Type: strings.Join(fields[:len(fields)-1], " "),
+				Name: fields[len(fields)-1],
+			})
+		}
+	}
+
+	return params
+}
+
+// getSummary returns the short Javadoc description rendered alongside a
+// member, if any.
+func getSummary(col *html.Node) string {
+	block, ok := summaryBlockSelector.MatchFirst(col)
+	if !ok {
+		return ""
+	}
+
+	return strings.TrimSpace(getInnerText(block))
+}
+
+// isDeprecated reports whether col carries Javadoc's "Deprecated." label.
+func isDeprecated(col *html.Node) bool {
+	_, ok := deprecatedLabelSelector.MatchFirst(col)
+	return ok
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+// OutputFormat selects how a crawled Index is serialized.
+type OutputFormat string
+
+const (
+	FormatJSON   OutputFormat = "json"
+	FormatSQLite OutputFormat = "sqlite"
+	FormatLSP    OutputFormat = "lsp"
+)
+
+// jsonIndex is the documented schema for the --format=json output: one
+// entry per crawled class page, keyed by its href relative to the
+// Javadoc root.
+type jsonIndex map[string]jsonClass
+
+type jsonClass struct {
+	StaticIdentifiers  []string     `json:"staticIdentifiers"`
+	VisibleIdentifiers []string     `json:"visibleIdentifiers"`
+	Members            []jsonMember `json:"members"`
+}
+
+type jsonMember struct {
+	Name       string      `json:"name"`
+	Kind       string      `json:"kind"`
+	Modifiers  []string    `json:"modifiers,omitempty"`
+	ReturnType string      `json:"returnType,omitempty"`
+	Params     []jsonParam `json:"params,omitempty"`
+	Deprecated bool        `json:"deprecated,omitempty"`
+	Summary    string      `json:"summary,omitempty"`
+	Anchor     string      `json:"anchor,omitempty"`
+}
+
+type jsonParam struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+func toJSONIndex(idx Index) jsonIndex {
+	out := make(jsonIndex, len(idx))
+	for href, ci := range idx {
+		jc := jsonClass{
+			StaticIdentifiers:  ci.staticIdentifiers,
+			VisibleIdentifiers: ci.visibleIdentifiers,
+		}
+
+		for _, m := range ci.Members {
+			jm := jsonMember{
+				Name:       m.Name,
+				Kind:       m.Kind.String(),
+				Modifiers:  m.Modifiers,
+				ReturnType: m.ReturnType,
+				Deprecated: m.Deprecated,
+				Summary:    m.Summary,
+				Anchor:     m.Anchor,
+			}
+
+			for _, p := range m.Params {
+				jm.Params = append(jm.Params, jsonParam{Type: p.Type, Name: p.Name})
+			}
+
+			jc.Members = append(jc.Members, jm)
+		}
+
+		out[href] = jc
+	}
+
+	return out
+}
+
+// WriteJSON serializes idx to w per the jsonIndex schema documented above.
+func WriteJSON(w io.Writer, idx Index) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(toJSONIndex(idx))
+}
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS package (
+	id   INTEGER PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS class (
+	id         INTEGER PRIMARY KEY,
+	package_id INTEGER NOT NULL REFERENCES package(id),
+	name       TEXT NOT NULL,
+	href       TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS member (
+	id          INTEGER PRIMARY KEY,
+	class_id    INTEGER NOT NULL REFERENCES class(id),
+	name        TEXT NOT NULL,
+	kind        TEXT NOT NULL,
+	return_type TEXT,
+	deprecated  INTEGER NOT NULL DEFAULT 0,
+	summary     TEXT,
+	anchor      TEXT
+);
+`
+
+// WriteSQLite serializes idx into a SQLite database at path, following
+// the package/class/member schema above so editors can query it with
+// LIKE instead of re-parsing Javadoc on every keystroke.
+func WriteSQLite(path string, idx Index) error {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("creating schema: %w", err)
+	}
+
+	packageIDs := make(map[string]int64)
+	for href, ci := range idx {
+		pkgName, className := splitPackageAndClass(href)
+
+		pkgID, ok := packageIDs[pkgName]
+		if !ok {
+			if _, err := db.Exec(`INSERT OR IGNORE INTO package(name) VALUES (?)`, pkgName); err != nil {
+				return fmt.Errorf("inserting package %s: %w", pkgName, err)
+			}
+
+			if err := db.QueryRow(`SELECT id FROM package WHERE name = ?`, pkgName).Scan(&pkgID); err != nil {
+				return fmt.Errorf("looking up package %s: %w", pkgName, err)
+			}
+
+			packageIDs[pkgName] = pkgID
+		}
+
+		if _, err := db.Exec(
+			`INSERT INTO class(package_id, name, href) VALUES (?, ?, ?)
+			 ON CONFLICT(href) DO UPDATE SET package_id = excluded.package_id, name = excluded.name`,
+			pkgID, className, href,
+		); err != nil {
+			return fmt.Errorf("upserting class %s: %w", href, err)
+		}
+
+		var classID int64
+		if err := db.QueryRow(`SELECT id FROM class WHERE href = ?`, href).Scan(&classID); err != nil {
+			return fmt.Errorf("looking up class %s: %w", href, err)
+		}
+
+		// A re-run against an existing database re-derives every member
+		// from the freshly crawled page, so drop whatever this class had
+		// before rather than accumulating duplicates.
+		if _, err := db.Exec(`DELETE FROM member WHERE class_id = ?`, classID); err != nil {
+			return fmt.Errorf("clearing stale members for %s: %w", href, err)
+		}
+
+		for _, m := range ci.Members {
+			if _, err := db.Exec(
+				`INSERT INTO member(class_id, name, kind, return_type, deprecated, summary, anchor) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+				classID, m.Name, m.Kind.String(), m.ReturnType, m.Deprecated, m.Summary, m.Anchor,
+			); err != nil {
+				return fmt.Errorf("inserting member %s.%s: %w", className, m.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// splitPackageAndClass derives a (package, class) pair from a class
+// page's href, e.g. "java/util/List.html" -> ("java.util", "List").
+func splitPackageAndClass(href string) (pkg, class string) {
+	trimmed := strings.TrimSuffix(href, ".html")
+	idx := strings.LastIndex(trimmed, "/")
+	if idx < 0 {
+		return "", trimmed
+	}
+
+	return strings.ReplaceAll(trimmed[:idx], "/", "."), trimmed[idx+1:]
+}
+
+// WorkspaceSymbol is a minimal, Language-Server-Protocol-compatible
+// symbol entry: just enough for an editor plugin to resolve "type or
+// member by fully-qualified name" without the tool having to speak full
+// LSP itself.
+type WorkspaceSymbol struct {
+	Name          string `json:"name"`
+	Kind          int    `json:"kind"`
+	ContainerName string `json:"containerName"`
+	Location      struct {
+		URI string `json:"uri"`
+	} `json:"location"`
+}
+
+// lspSymbolKind maps a MemberKind to the LSP SymbolKind enum
+// (https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/#symbolKind).
+func lspSymbolKind(k MemberKind) int {
+	const (
+		symbolKindClass       = 5
+		symbolKindMethod      = 6
+		symbolKindField       = 8
+		symbolKindConstructor = 9
+	)
+
+	switch k {
+	case KindMethod:
+		return symbolKindMethod
+	case KindConstructor:
+		return symbolKindConstructor
+	case KindNested:
+		return symbolKindClass
+	default:
+		return symbolKindField
+	}
+}
+
+// WriteLSP serializes idx to w as a WorkspaceSymbol[] dump, resolving
+// each member's location against rootURL.
+func WriteLSP(w io.Writer, rootURL string, idx Index) error {
+	root, err := url.Parse(rootURL)
+	if err != nil {
+		return fmt.Errorf("parsing root URL: %w", err)
+	}
+
+	var symbols []WorkspaceSymbol
+	for href, ci := range idx {
+		pkgName, className := splitPackageAndClass(href)
+		container := className
+		if pkgName != "" {
+			container = pkgName + "." + className
+		}
+
+		ref, err := url.Parse(href)
+		if err != nil {
+			return fmt.Errorf("parsing class href %q: %w", href, err)
+		}
+		pageURI := root.ResolveReference(ref).String()
+
+		for _, m := range ci.Members {
+			sym := WorkspaceSymbol{
+				Name:          m.Name,
+				Kind:          lspSymbolKind(m.Kind),
+				ContainerName: container,
+			}
+
+			sym.Location.URI = pageURI
+			if m.Anchor != "" {
+				sym.Location.URI += "#" + m.Anchor
+			}
+
+			symbols = append(symbols, sym)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(symbols)
+}
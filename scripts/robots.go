@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// robotsPolicy is a minimal robots.txt representation: the set of
+// Disallow prefixes that apply to this crawler's user agent (or to "*"
+// when no agent-specific group exists).
+type robotsPolicy struct {
+	disallow []string
+}
+
+const crawlerUserAgent = "javaimports-javadoc-crawler"
+
+// fetchRobotsPolicy fetches and parses /robots.txt from root's host. A
+// missing or unreadable robots.txt is treated as "everything allowed",
+// matching how most crawlers behave when the file is absent.
+func fetchRobotsPolicy(ctx context.Context, client *http.Client, root *url.URL) (*robotsPolicy, error) {
+	robotsURL := &url.URL{Scheme: root.Scheme, Host: root.Host, Path: "/robots.txt"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", crawlerUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return &robotsPolicy{}, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return &robotsPolicy{}, nil
+	}
+
+	return parseRobotsTxt(resp.Body), nil
+}
+
+// parseRobotsTxt reads a robots.txt body and collects the Disallow rules
+// that apply to us: first any group addressed to crawlerUserAgent,
+// falling back to the "*" group if there is no specific one.
+func parseRobotsTxt(body io.Reader) *robotsPolicy {
+	scanner := bufio.NewScanner(body)
+
+	var (
+		wildcard, specific []string
+		sawSpecific        bool
+		inRules            bool
+		currentAgents      []string
+		currentDisallow    []string
+	)
+
+	flush := func() {
+		for _, agent := range currentAgents {
+			if agent == "*" {
+				wildcard = append(wildcard, currentDisallow...)
+			}
+			if agent == crawlerUserAgent {
+				sawSpecific = true
+				specific = append(specific, currentDisallow...)
+			}
+		}
+		currentAgents = nil
+		currentDisallow = nil
+		inRules = false
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if inRules {
+				flush()
+			}
+			currentAgents = append(currentAgents, value)
+		case "disallow":
+			inRules = true
+			if value != "" {
+				currentDisallow = append(currentDisallow, value)
+			}
+		}
+	}
+	flush()
+
+	if sawSpecific {
+		return &robotsPolicy{disallow: specific}
+	}
+
+	return &robotsPolicy{disallow: wildcard}
+}
+
+// Allowed reports whether path may be fetched under this policy.
+func (p *robotsPolicy) Allowed(path string) bool {
+	if p == nil {
+		return true
+	}
+
+	for _, prefix := range p.disallow {
+		if strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+
+	return true
+}
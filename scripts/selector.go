@@ -0,0 +1,56 @@
+package main
+
+import (
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/net/html"
+)
+
+// Selector wraps a compiled CSS selector, letting extraction rules for a
+// Javadoc layout be written as declarative strings (e.g.
+// "table.memberSummary tbody tr") instead of hand-rolled recursive walks.
+type Selector struct {
+	sel cascadia.Selector
+}
+
+// MustCompileSelector compiles expr into a Selector. It panics on an
+// invalid selector, which can only happen as a programmer error in a
+// package-level declaration.
+func MustCompileSelector(expr string) Selector {
+	return Selector{sel: cascadia.MustCompile(expr)}
+}
+
+// MatchAll returns every node under root matching the selector, in
+// document order.
+func (s Selector) MatchAll(root *html.Node) []*html.Node {
+	return s.sel.MatchAll(root)
+}
+
+// MatchFirst returns the first node under root matching the selector, if
+// any.
+func (s Selector) MatchFirst(root *html.Node) (*html.Node, bool) {
+	n := s.sel.MatchFirst(root)
+	return n, n != nil
+}
+
+// Matches reports whether n itself, rather than one of its descendants,
+// matches the selector.
+func (s Selector) Matches(n *html.Node) bool {
+	return s.sel.Match(n)
+}
+
+// ExtractOptions controls how a ClassInfoExtractor behaves when it runs
+// into a member it can't parse.
+type ExtractOptions struct {
+	// FailFast aborts extraction at the first malformed member instead of
+	// skipping it and collecting every failure into a MultiError.
+	FailFast bool
+}
+
+// ClassInfoExtractor populates a classInfo from a single rendered Javadoc
+// HTML page. Each supported Javadoc layout (the legacy <table> summary,
+// the Java 9+ <div> summary, ...) implements it with its own set of
+// selectors, so populateClassInfoFromHtml can dispatch to whichever one
+// matches the page at hand.
+type ClassInfoExtractor interface {
+	Extract(ci *classInfo, doc *html.Node, opts ExtractOptions) error
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// javadocLayout identifies which HTML structure a Javadoc page uses for
+// its member summary tables.
+type javadocLayout int
+
+const (
+	layoutTableSummary javadocLayout = iota // Java 8 and earlier: <table class="memberSummary">
+	layoutDivSummary                        // Java 9 and later: <div class="summary-table">
+)
+
+var generatorMetaSelector = MustCompileSelector(`meta[name="generator"]`)
+
+// detectLayout inspects doc and reports which Javadoc member summary
+// layout it uses. The generator meta tag is checked first since it's a
+// single node lookup; the presence of div.summary-table is the fallback
+// for pages that lack (or lie about) the generator tag.
+func detectLayout(doc *html.Node) javadocLayout {
+	if meta, found := generatorMetaSelector.MatchFirst(doc); found {
+		if content, ok := getAttributeValue(meta, "content"); ok && isModernGenerator(content) {
+			return layoutDivSummary
+		}
+	}
+
+	if _, found := summaryTableDivSelector.MatchFirst(doc); found {
+		return layoutDivSummary
+	}
+
+	return layoutTableSummary
+}
+
+// isModernGenerator reports whether a Javadoc "generator" meta tag's
+// content string (e.g. "javadoc/13") identifies a Java 9+ toolchain.
+func isModernGenerator(content string) bool {
+	const prefix = "javadoc/"
+	idx := strings.Index(content, prefix)
+	if idx < 0 {
+		return false
+	}
+
+	major, _, _ := strings.Cut(content[idx+len(prefix):], ".")
+	n, err := strconv.Atoi(major)
+	return err == nil && n >= 9
+}
+
+// extractorFor returns the ClassInfoExtractor appropriate for layout.
+func extractorFor(layout javadocLayout) ClassInfoExtractor {
+	switch layout {
+	case layoutDivSummary:
+		return divSummaryExtractor{}
+	default:
+		return tableSummaryExtractor{}
+	}
+}